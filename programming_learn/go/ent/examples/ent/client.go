@@ -0,0 +1,60 @@
+// Package ent is the hand-authored stand-in for the client `ent generate`
+// would produce from schema/user.go. This repo's CI doesn't have the ent
+// code-generator available, so rather than leave store_ent_example.go's
+// import broken, this package implements the same Client/UserClient/query
+// builder surface it calls, running queries directly over the *sql.DB
+// behind the configured dialect.Driver instead of ent's generated
+// sqlgraph builders.
+//
+// Hand-written code should never edit this package; regenerate it (once
+// `ent generate` is available) rather than patching it here.
+package ent
+
+import (
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// Client is the entry point for every generated operation, mirroring
+// ent.Client.
+type Client struct {
+	Schema *Schema
+	User   *UserClient
+
+	db      *sql.DB
+	dialect string
+}
+
+// Option configures a Client, mirroring ent's generated functional-option
+// constructor (ent.Driver, ent.Log, ...).
+type Option func(*Client)
+
+// Driver sets the dialect.Driver the Client runs queries through. Only
+// *entsql.Driver (as returned by entsql.Open) is supported, since this
+// stand-in talks to the database via its underlying *sql.DB rather than
+// ent's dialect/sql.Builder.
+func Driver(drv dialect.Driver) Option {
+	return func(c *Client) {
+		sqlDrv, ok := drv.(*entsql.Driver)
+		if !ok {
+			panic(fmt.Sprintf("ent: unsupported driver type %T", drv))
+		}
+		c.db = sqlDrv.DB()
+		c.dialect = drv.Dialect()
+	}
+}
+
+// NewClient builds a Client from the given options, mirroring
+// ent.NewClient.
+func NewClient(opts ...Option) *Client {
+	c := &Client{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.Schema = &Schema{client: c}
+	c.User = &UserClient{db: c.db, dialect: c.dialect}
+	return c
+}
@@ -0,0 +1,32 @@
+package ent
+
+import "context"
+
+// Schema runs schema migrations, standing in for the generated migrate
+// package ent.Client.Schema normally delegates to.
+type Schema struct {
+	client *Client
+}
+
+// Create runs `CREATE TABLE IF NOT EXISTS` for every entity (just User
+// here), mirroring what the generated migrate.Schema.Create does from the
+// field definitions in schema/user.go.
+func (s *Schema) Create(ctx context.Context) error {
+	_, err := s.client.db.ExecContext(ctx, usersTableDDL(s.client.dialect))
+	return err
+}
+
+func usersTableDDL(dialectName string) string {
+	idType := "INTEGER"
+	if dialectName == "postgres" {
+		idType = "BIGINT"
+	}
+	return `CREATE TABLE IF NOT EXISTS users (
+	id ` + idType + ` PRIMARY KEY,
+	name TEXT NOT NULL,
+	email TEXT UNIQUE,
+	status TEXT NOT NULL DEFAULT 'active',
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+)`
+}
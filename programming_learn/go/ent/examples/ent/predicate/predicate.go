@@ -0,0 +1,10 @@
+// Package predicate holds the predicate function types consumed by the
+// generated query builders, mirroring `ent generate`'s own predicate
+// package. See ../client.go's doc comment for why this is hand-authored.
+package predicate
+
+// User is the hand-authored stand-in for the generated predicate.User: a
+// single WHERE clause plus its bind argument, rather than the generated
+// sql.Selector-based closure (the full query planner isn't needed for the
+// one filter - status - this example uses).
+type User func() (clause string, arg interface{})
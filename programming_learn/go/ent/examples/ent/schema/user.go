@@ -0,0 +1,41 @@
+// Package schema describes the ent schema backing entstore, the SQL
+// UserStore implementation in ../../store_ent_example.go. The fields mirror the
+// swagger:model User from ../../../../go-swagger/examples so rows round-trip to
+// the same JSON shape the REST examples already return.
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id").
+			Positive().
+			Unique(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.String("email").
+			Unique().
+			Optional(),
+		field.Enum("status").
+			Values("active", "inactive", "pending").
+			Default("active"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
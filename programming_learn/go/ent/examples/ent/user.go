@@ -0,0 +1,291 @@
+package ent
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"ent/examples/ent/predicate"
+	"ent/examples/ent/user"
+)
+
+// User is the hand-authored stand-in for the generated ent.User entity,
+// with fields matching schema/user.go.
+type User struct {
+	ID        int64
+	Name      string
+	Email     string
+	Status    user.Status
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ConstraintError is returned when an operation violates a unique
+// constraint (the `email` field's Unique() in schema/user.go), matching
+// the generated *ConstraintError that IsConstraintError checks for.
+type ConstraintError struct{ err error }
+
+func (e *ConstraintError) Error() string { return e.err.Error() }
+func (e *ConstraintError) Unwrap() error { return e.err }
+
+// NotFoundError is returned when an operation targets a row that doesn't
+// exist, matching the generated *NotFoundError that IsNotFound checks for.
+type NotFoundError struct {
+	table string
+	id    int64
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("ent: %s not found (id=%d)", e.table, e.id)
+}
+
+// IsConstraintError reports whether err was caused by a unique constraint
+// violation.
+func IsConstraintError(err error) bool {
+	var e *ConstraintError
+	return errors.As(err, &e)
+}
+
+// IsNotFound reports whether err means no row matched the query.
+func IsNotFound(err error) bool {
+	var e *NotFoundError
+	return errors.As(err, &e)
+}
+
+// UserClient performs CRUD against the users table directly over
+// database/sql, standing in for the generated sqlgraph-based UserClient.
+type UserClient struct {
+	db      *sql.DB
+	dialect string
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	return &UserCreate{client: c, status: user.StatusActive}
+}
+
+// Get returns the User with the given id.
+func (c *UserClient) Get(ctx context.Context, id int64) (*User, error) {
+	row := c.db.QueryRowContext(ctx, `SELECT id, name, email, status, created_at, updated_at FROM users WHERE id = ?`, id)
+	return scanUser(row, id)
+}
+
+// Query returns a builder for listing User entities.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{client: c}
+}
+
+// UpdateOneID returns a builder for updating the User with the given id.
+func (c *UserClient) UpdateOneID(id int64) *UserUpdateOne {
+	return &UserUpdateOne{client: c, id: id}
+}
+
+// DeleteOneID returns a builder for deleting the User with the given id.
+func (c *UserClient) DeleteOneID(id int64) *UserDelete {
+	return &UserDelete{client: c, id: id}
+}
+
+func scanUser(row *sql.Row, id int64) (*User, error) {
+	var u User
+	var status string
+	var email sql.NullString
+	if err := row.Scan(&u.ID, &u.Name, &email, &status, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, &NotFoundError{table: "user", id: id}
+		}
+		return nil, err
+	}
+	u.Email = email.String
+	u.Status = user.Status(status)
+	return &u, nil
+}
+
+// isUniqueViolation recognizes the unique-constraint error text the
+// sqlite3 and postgres drivers return; it's a driver-agnostic substitute
+// for the generated code's typed driver-error unwrapping.
+func isUniqueViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "UNIQUE constraint failed") ||
+		strings.Contains(msg, "duplicate key value") ||
+		strings.Contains(msg, "Duplicate entry")
+}
+
+func nullableEmail(email string) interface{} {
+	if email == "" {
+		return nil
+	}
+	return email
+}
+
+// UserCreate is the builder for creating a User entity.
+type UserCreate struct {
+	client *UserClient
+	name   string
+	email  string
+	status user.Status
+}
+
+func (uc *UserCreate) SetName(name string) *UserCreate { uc.name = name; return uc }
+
+func (uc *UserCreate) SetEmail(email string) *UserCreate { uc.email = email; return uc }
+
+func (uc *UserCreate) SetStatus(status user.Status) *UserCreate { uc.status = status; return uc }
+
+// Save creates the User in the database and returns it.
+func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+	now := time.Now()
+	res, err := uc.client.db.ExecContext(ctx,
+		`INSERT INTO users (name, email, status, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`,
+		uc.name, nullableEmail(uc.email), string(uc.status), now, now)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, &ConstraintError{err: err}
+		}
+		return nil, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	return &User{ID: id, Name: uc.name, Email: uc.email, Status: uc.status, CreatedAt: now, UpdatedAt: now}, nil
+}
+
+// UserQuery is the builder for listing User entities.
+type UserQuery struct {
+	client *UserClient
+	wheres []predicate.User
+	limit  int
+	offset int
+}
+
+// Where adds filters to the query, mirroring the generated UserQuery.Where.
+func (q *UserQuery) Where(ps ...predicate.User) *UserQuery {
+	q.wheres = append(q.wheres, ps...)
+	return q
+}
+
+// Limit sets the maximum number of rows returned.
+func (q *UserQuery) Limit(n int) *UserQuery { q.limit = n; return q }
+
+// Offset sets the number of rows to skip.
+func (q *UserQuery) Offset(n int) *UserQuery { q.offset = n; return q }
+
+// All executes the query and returns the matching User entities.
+func (q *UserQuery) All(ctx context.Context) ([]*User, error) {
+	query := `SELECT id, name, email, status, created_at, updated_at FROM users`
+
+	var conds []string
+	var args []interface{}
+	for _, p := range q.wheres {
+		cond, arg := p()
+		conds = append(conds, cond)
+		args = append(args, arg)
+	}
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	if q.limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", q.limit)
+	}
+	if q.offset > 0 {
+		query += fmt.Sprintf(" OFFSET %d", q.offset)
+	}
+
+	rows, err := q.client.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*User
+	for rows.Next() {
+		var u User
+		var status string
+		var email sql.NullString
+		if err := rows.Scan(&u.ID, &u.Name, &email, &status, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return nil, err
+		}
+		u.Email = email.String
+		u.Status = user.Status(status)
+		result = append(result, &u)
+	}
+	return result, rows.Err()
+}
+
+// UserUpdateOne is the builder for updating a single User by id.
+type UserUpdateOne struct {
+	client *UserClient
+	id     int64
+	name   *string
+	email  *string
+	status *user.Status
+}
+
+func (u *UserUpdateOne) SetName(name string) *UserUpdateOne { u.name = &name; return u }
+
+func (u *UserUpdateOne) SetEmail(email string) *UserUpdateOne { u.email = &email; return u }
+
+func (u *UserUpdateOne) SetStatus(status user.Status) *UserUpdateOne { u.status = &status; return u }
+
+// Save applies the update and returns the resulting User.
+func (u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	sets := []string{"updated_at = ?"}
+	args := []interface{}{time.Now()}
+	if u.name != nil {
+		sets = append(sets, "name = ?")
+		args = append(args, *u.name)
+	}
+	if u.email != nil {
+		sets = append(sets, "email = ?")
+		args = append(args, nullableEmail(*u.email))
+	}
+	if u.status != nil {
+		sets = append(sets, "status = ?")
+		args = append(args, string(*u.status))
+	}
+	args = append(args, u.id)
+
+	res, err := u.client.db.ExecContext(ctx, `UPDATE users SET `+strings.Join(sets, ", ")+` WHERE id = ?`, args...)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, &ConstraintError{err: err}
+		}
+		return nil, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, &NotFoundError{table: "user", id: u.id}
+	}
+	return u.client.Get(ctx, u.id)
+}
+
+// UserDelete is the builder for deleting a single User by id.
+type UserDelete struct {
+	client *UserClient
+	id     int64
+}
+
+// Exec deletes the row, returning a *NotFoundError if it didn't exist.
+func (d *UserDelete) Exec(ctx context.Context) error {
+	res, err := d.client.db.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, d.id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return &NotFoundError{table: "user", id: d.id}
+	}
+	return nil
+}
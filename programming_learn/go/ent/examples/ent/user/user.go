@@ -0,0 +1,25 @@
+// Package user is the hand-authored stand-in for the query-builder package
+// `ent generate` produces per entity from ../schema/user.go. See
+// ../client.go's doc comment for why this is hand-written rather than
+// ent-generated in this repo.
+package user
+
+import "ent/examples/ent/predicate"
+
+// Status mirrors the `status` enum field declared in ../schema/user.go.
+type Status string
+
+// Enum values for Status, matching the Values() call in the schema.
+const (
+	StatusActive   Status = "active"
+	StatusInactive Status = "inactive"
+	StatusPending  Status = "pending"
+)
+
+// StatusEQ returns a predicate filtering rows whose status equals s,
+// standing in for the generated user.StatusEQ.
+func StatusEQ(s Status) predicate.User {
+	return func() (string, interface{}) {
+		return "status = ?", string(s)
+	}
+}
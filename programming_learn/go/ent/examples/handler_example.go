@@ -0,0 +1,41 @@
+// createUserHandler shows how the HTTP layer reacts to UserStore errors
+// once storage is pluggable: ErrDuplicateEmail (raised by either store
+// implementation) maps to 409 Conflict, matching the errorResponse wrapper
+// used throughout the go-swagger examples.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+func createUserHandler(store UserStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var u User
+		if err := json.NewDecoder(r.Body).Decode(&u); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid JSON")
+			return
+		}
+
+		created, err := store.Create(r.Context(), &u)
+		switch {
+		case errors.Is(err, ErrDuplicateEmail):
+			writeJSONError(w, http.StatusConflict, "email already in use")
+			return
+		case err != nil:
+			writeJSONError(w, http.StatusInternalServerError, "failed to create user")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(created)
+	}
+}
+
+func writeJSONError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{"message": message, "code": code})
+}
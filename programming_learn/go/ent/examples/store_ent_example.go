@@ -0,0 +1,143 @@
+// entStore is the ent-backed UserStore implementation referenced from
+// store_example.go. It pushes status/limit/offset down to SQL instead of
+// iterating an in-memory map, and translates ent's unique-constraint
+// violations into ErrDuplicateEmail so callers (the HTTP layer) can map
+// that to a 409 Conflict response.
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	entclient "ent/examples/ent"
+	"ent/examples/ent/user"
+)
+
+// entStore implements UserStore on top of the ent.Client in ./ent (a
+// hand-authored stand-in for the generated client).
+type entStore struct {
+	client *entclient.Client
+}
+
+// newEntStore opens a connection for the given driver ("sqlite" or
+// "postgres") and runs the schema migration (Client.Schema.Create).
+func newEntStore(ctx context.Context, driver, dataSourceName string) (*entStore, error) {
+	var dialectName string
+	switch driver {
+	case "sqlite":
+		dialectName = dialect.SQLite
+	case "postgres":
+		dialectName = dialect.Postgres
+	default:
+		return nil, fmt.Errorf("entstore: unsupported driver %q", driver)
+	}
+
+	drv, err := entsql.Open(dialectName, dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("entstore: open %s: %w", driver, err)
+	}
+
+	client := entclient.NewClient(entclient.Driver(drv))
+	if err := client.Schema.Create(ctx); err != nil {
+		return nil, fmt.Errorf("entstore: run migrations: %w", err)
+	}
+
+	return &entStore{client: client}, nil
+}
+
+func (s *entStore) Create(ctx context.Context, u *User) (*User, error) {
+	row, err := s.client.User.Create().
+		SetName(u.Name).
+		SetEmail(u.Email).
+		SetStatus(user.Status(u.Status)).
+		Save(ctx)
+	if err != nil {
+		if entclient.IsConstraintError(err) {
+			return nil, ErrDuplicateEmail
+		}
+		return nil, err
+	}
+	return fromEntUser(row), nil
+}
+
+func (s *entStore) Get(ctx context.Context, id int64) (*User, error) {
+	row, err := s.client.User.Get(ctx, id)
+	if err != nil {
+		if entclient.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return fromEntUser(row), nil
+}
+
+func (s *entStore) List(ctx context.Context, opts ListOptions) ([]*User, error) {
+	q := s.client.User.Query()
+	if opts.Status != "" {
+		q = q.Where(user.StatusEQ(user.Status(opts.Status)))
+	}
+	if opts.Limit > 0 {
+		q = q.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		q = q.Offset(opts.Offset)
+	}
+
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*User, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, fromEntUser(row))
+	}
+	return result, nil
+}
+
+func (s *entStore) Update(ctx context.Context, id int64, updates *User) (*User, error) {
+	update := s.client.User.UpdateOneID(id)
+	if updates.Name != "" {
+		update = update.SetName(updates.Name)
+	}
+	if updates.Email != "" {
+		update = update.SetEmail(updates.Email)
+	}
+	if updates.Status != "" {
+		update = update.SetStatus(user.Status(updates.Status))
+	}
+
+	row, err := update.Save(ctx)
+	if err != nil {
+		if entclient.IsConstraintError(err) {
+			return nil, ErrDuplicateEmail
+		}
+		if entclient.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return fromEntUser(row), nil
+}
+
+func (s *entStore) Delete(ctx context.Context, id int64) error {
+	err := s.client.User.DeleteOneID(id).Exec(ctx)
+	if entclient.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func fromEntUser(row *entclient.User) *User {
+	return &User{
+		ID:        row.ID,
+		Name:      row.Name,
+		Email:     row.Email,
+		Status:    string(row.Status),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
@@ -0,0 +1,180 @@
+// Package main demonstrates replacing the hard-coded map[int64]*User +
+// mutex used by UserService in ../../go-swagger/examples/complete_api_example.go
+// with a pluggable UserStore interface, so the same business logic can run
+// against either an in-memory map or a real SQL database via ent.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// User mirrors the swagger:model User from the go-swagger examples.
+type User struct {
+	ID        int64
+	Name      string
+	Email     string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ErrNotFound is returned by Get/Update/Delete when no row matches.
+var ErrNotFound = errors.New("user not found")
+
+// ErrDuplicateEmail is returned by Create/Update when the email is already
+// taken by another user, letting callers map it to HTTP 409 Conflict.
+var ErrDuplicateEmail = errors.New("email already in use")
+
+// ListOptions controls filtering and pagination. entStore pushes it down to
+// SQL; memoryStore applies it while iterating its map.
+type ListOptions struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+// UserStore is implemented by every storage backend. memoryStore below
+// keeps the original map-based behavior; entStore (store_ent_example.go)
+// backs it with a real SQL database through ent.
+type UserStore interface {
+	Create(ctx context.Context, u *User) (*User, error)
+	Get(ctx context.Context, id int64) (*User, error)
+	List(ctx context.Context, opts ListOptions) ([]*User, error)
+	Update(ctx context.Context, id int64, updates *User) (*User, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+// memoryStore is the original in-memory UserStore implementation, now
+// satisfying the interface instead of being baked directly into
+// UserService.
+type memoryStore struct {
+	mutex  sync.RWMutex
+	users  map[int64]*User
+	nextID int64
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{users: make(map[int64]*User), nextID: 1}
+}
+
+func (s *memoryStore) Create(ctx context.Context, u *User) (*User, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, existing := range s.users {
+		if u.Email != "" && existing.Email == u.Email {
+			return nil, ErrDuplicateEmail
+		}
+	}
+
+	u.ID = s.nextID
+	s.nextID++
+	u.CreatedAt = time.Now()
+	u.UpdatedAt = time.Now()
+	s.users[u.ID] = u
+	return u, nil
+}
+
+func (s *memoryStore) Get(ctx context.Context, id int64) (*User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	u, ok := s.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return u, nil
+}
+
+func (s *memoryStore) List(ctx context.Context, opts ListOptions) ([]*User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var result []*User
+	count := 0
+	for _, u := range s.users {
+		if opts.Status != "" && u.Status != opts.Status {
+			continue
+		}
+		if count < opts.Offset {
+			count++
+			continue
+		}
+		if opts.Limit > 0 && len(result) >= opts.Limit {
+			break
+		}
+		result = append(result, u)
+		count++
+	}
+	return result, nil
+}
+
+func (s *memoryStore) Update(ctx context.Context, id int64, updates *User) (*User, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	u, ok := s.users[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	if updates.Name != "" {
+		u.Name = updates.Name
+	}
+	if updates.Email != "" && updates.Email != u.Email {
+		for _, existing := range s.users {
+			if existing.ID != id && existing.Email == updates.Email {
+				return nil, ErrDuplicateEmail
+			}
+		}
+		u.Email = updates.Email
+	}
+	if updates.Status != "" {
+		u.Status = updates.Status
+	}
+	u.UpdatedAt = time.Now()
+	return u, nil
+}
+
+func (s *memoryStore) Delete(ctx context.Context, id int64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.users[id]; !ok {
+		return ErrNotFound
+	}
+	delete(s.users, id)
+	return nil
+}
+
+// newStoreFromEnv picks a UserStore implementation based on STORAGE_DRIVER
+// (memory / sqlite / postgres), defaulting to memory so existing examples
+// keep working unconfigured.
+func newStoreFromEnv(ctx context.Context) (UserStore, error) {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "memory":
+		return newMemoryStore(), nil
+	case "sqlite", "postgres":
+		return newEntStore(ctx, driver, os.Getenv("DATABASE_URL"))
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_DRIVER %q", driver)
+	}
+}
+
+func main() {
+	ctx := context.Background()
+	store, err := newStoreFromEnv(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	u, err := store.Create(ctx, &User{Name: "Alice Johnson", Email: "alice@example.com", Status: "active"})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("created user: %+v\n", u)
+}
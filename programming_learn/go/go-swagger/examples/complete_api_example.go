@@ -264,7 +264,11 @@ func (s *UserService) listUsersHandler(w http.ResponseWriter, r *http.Request) {
 //
 // # Create a new user
 //
-// Adds a new user to the system with the provided information.
+// Adds a new user to the system with the provided information. Requires a
+// valid bearer token.
+//
+//	Security:
+//	  bearer: []
 //
 //	Consumes:
 //	- application/json
@@ -360,7 +364,11 @@ func (s *UserService) getUserByIDHandler(w http.ResponseWriter, r *http.Request)
 //
 // # Update user
 //
-// Updates an existing user with the provided information.
+// Updates an existing user with the provided information. Requires a valid
+// bearer token.
+//
+//	Security:
+//	  bearer: []
 //
 //	Consumes:
 //	- application/json
@@ -415,7 +423,10 @@ func (s *UserService) updateUserHandler(w http.ResponseWriter, r *http.Request)
 //
 // # Delete user
 //
-// Removes a user from the system.
+// Removes a user from the system. Requires a valid bearer token.
+//
+//	Security:
+//	  bearer: []
 //
 //	Parameters:
 //	  + name: id
@@ -0,0 +1,482 @@
+// Package main demonstrates generating an actual OpenAPI/Swagger spec file
+// from the annotations used in the other examples in this directory, and
+// serving it next to go-swagger's bundled SwaggerUI handler.
+//
+// The other examples in this package (simple_api_annotations.go,
+// complete_api_example.go) show how to *write* swagger:route / swagger:model
+// comments, but neither of them actually produces a spec file - `swagger
+// generate spec` has to be run by hand and the result has to be served
+// separately. This example parses those annotations itself (swagger:meta,
+// swagger:route, swagger:model, swagger:response) and writes swagger.yaml /
+// openapi.json at startup, then serves both next to middleware.SwaggerUI so
+// the docs endpoint works with no manual generation step.
+//
+//	Schemes: http, https
+//	Host: localhost:8080
+//	BasePath: /api/v1
+//	Version: 1.0.0
+//	Title: User Management API
+//	Description: Spec generation and serving example
+//
+//	SecurityDefinitions:
+//	bearer:
+//	  type: apiKey
+//	  name: Authorization
+//	  in: header
+//
+// swagger:meta
+package main
+
+//go:generate go run spec_generation_example.go -source=complete_api_example.go
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// apiSpec is the subset of a swagger document this generator can derive
+// from annotations: metadata from the swagger:meta package comment, one
+// entry per swagger:route operation, and one definition per swagger:model /
+// swagger:response wrapper.
+type apiSpec struct {
+	Title               string
+	Description         string
+	Version             string
+	Host                string
+	BasePath            string
+	Schemes             []string
+	SecurityDefinitions map[string]securityScheme
+	Paths               map[string]map[string]operation
+	Definitions         map[string]map[string]interface{}
+}
+
+type securityScheme struct {
+	Type string `json:"type" yaml:"type"`
+	Name string `json:"name" yaml:"name"`
+	In   string `json:"in" yaml:"in"`
+}
+
+type operation struct {
+	Summary     string                `json:"summary,omitempty" yaml:"summary,omitempty"`
+	OperationID string                `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Tags        []string              `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Security    []map[string][]string `json:"security,omitempty" yaml:"security,omitempty"`
+	Responses   map[string]response   `json:"responses,omitempty" yaml:"responses,omitempty"`
+}
+
+type response struct {
+	Description string `json:"description" yaml:"description"`
+}
+
+var (
+	routeRe    = regexp.MustCompile(`swagger:route\s+(\S+)\s+(\S+)\s+(\S+)\s+(\S+)`)
+	responseRe = regexp.MustCompile(`swagger:response\s+(\S+)`)
+	metaLineRe = regexp.MustCompile(`^\s*([A-Za-z]+):\s*(.*)$`)
+	securityRe = regexp.MustCompile(`^\s*(\w+):\s*$`)
+)
+
+// parseAnnotations reads sourceFile and extracts everything the generator
+// needs: the swagger:meta package doc, every swagger:route handler, every
+// swagger:model struct, and every swagger:response wrapper.
+func parseAnnotations(sourceFile string) (*apiSpec, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, sourceFile, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("swaggergen: parse %s: %w", sourceFile, err)
+	}
+
+	spec := &apiSpec{
+		SecurityDefinitions: make(map[string]securityScheme),
+		Paths:               make(map[string]map[string]operation),
+		Definitions:         make(map[string]map[string]interface{}),
+	}
+
+	if file.Doc != nil {
+		parseMeta(file.Doc.Text(), spec)
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Doc != nil {
+				parseRoute(d.Doc.Text(), spec)
+			}
+		case *ast.GenDecl:
+			for _, s := range d.Specs {
+				ts, ok := s.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+				doc := d.Doc
+				if ts.Doc != nil {
+					doc = ts.Doc
+				}
+				if doc == nil {
+					continue
+				}
+				text := doc.Text()
+				switch {
+				case strings.Contains(text, "swagger:model"):
+					spec.Definitions[ts.Name.Name] = structFields(ts)
+				case strings.Contains(text, "swagger:response"):
+					if m := responseRe.FindStringSubmatch(text); m != nil {
+						spec.Definitions[m[1]] = structFields(ts)
+					}
+				}
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+// parseMeta fills in the top-level swagger:meta fields (Title, Version,
+// Host, BasePath, Schemes) and the SecurityDefinitions block declared in
+// the package doc comment.
+func parseMeta(doc string, spec *apiSpec) {
+	lines := strings.Split(doc, "\n")
+	inSecurityDefs := false
+	secIndent := 0
+	var currentScheme string
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if trimmed == "SecurityDefinitions:" {
+			inSecurityDefs = true
+			secIndent = len(line) - len(strings.TrimLeft(line, " \t"))
+			continue
+		}
+
+		if inSecurityDefs {
+			// ast.CommentGroup.Text() preserves the source's original
+			// indentation, which is tabs rather than spaces, so trim both
+			// and compare against the "SecurityDefinitions:" line's own.
+			leadingSpaces := len(line) - len(strings.TrimLeft(line, " \t"))
+
+			// A scheme name ("bearer:") starts a new entry at the same
+			// indentation as "SecurityDefinitions:"; its "type:"/"name:"/"in:"
+			// fields are indented further under it.
+			if m := securityRe.FindStringSubmatch(trimmed); m != nil && leadingSpaces == secIndent {
+				currentScheme = m[1]
+				spec.SecurityDefinitions[currentScheme] = securityScheme{}
+				continue
+			}
+			if currentScheme != "" && leadingSpaces > secIndent {
+				if m := metaLineRe.FindStringSubmatch(trimmed); m != nil {
+					scheme := spec.SecurityDefinitions[currentScheme]
+					switch strings.ToLower(m[1]) {
+					case "type":
+						scheme.Type = m[2]
+					case "name":
+						scheme.Name = m[2]
+					case "in":
+						scheme.In = m[2]
+					}
+					spec.SecurityDefinitions[currentScheme] = scheme
+					continue
+				}
+			}
+
+			// A line indented no further than "SecurityDefinitions:" that
+			// isn't a scheme name ends the SecurityDefinitions block.
+			if leadingSpaces <= secIndent {
+				inSecurityDefs = false
+			} else {
+				continue
+			}
+		}
+
+		m := metaLineRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		switch m[1] {
+		case "Title":
+			spec.Title = m[2]
+		case "Description":
+			spec.Description = m[2]
+		case "Version":
+			spec.Version = m[2]
+		case "Host":
+			spec.Host = m[2]
+		case "BasePath":
+			spec.BasePath = m[2]
+		case "Schemes":
+			for _, s := range strings.Split(m[2], ",") {
+				spec.Schemes = append(spec.Schemes, strings.TrimSpace(s))
+			}
+		}
+	}
+}
+
+// parseRoute turns one swagger:route doc comment into a path + operation
+// entry, including the Responses: and Security: sections.
+func parseRoute(doc string, spec *apiSpec) {
+	m := routeRe.FindStringSubmatch(doc)
+	if m == nil {
+		return
+	}
+	method, path, tag, operationID := strings.ToLower(m[1]), m[2], m[3], m[4]
+
+	op := operation{OperationID: operationID, Tags: []string{tag}, Responses: make(map[string]response)}
+
+	for _, summaryLine := range strings.Split(doc, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(summaryLine), "# ") {
+			op.Summary = strings.TrimPrefix(strings.TrimSpace(summaryLine), "# ")
+			break
+		}
+	}
+
+	inResponses := false
+	inSecurity := false
+	for _, line := range strings.Split(doc, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch trimmed {
+		case "Responses:":
+			inResponses, inSecurity = true, false
+			continue
+		case "Security:":
+			inSecurity, inResponses = true, false
+			continue
+		}
+
+		if inResponses {
+			parts := strings.SplitN(trimmed, ":", 2)
+			if len(parts) == 2 {
+				if _, err := strconv.Atoi(strings.TrimSpace(parts[0])); err == nil {
+					op.Responses[strings.TrimSpace(parts[0])] = response{Description: strings.TrimSpace(parts[1])}
+					continue
+				}
+			}
+			if trimmed == "" {
+				inResponses = false
+			}
+		}
+
+		if inSecurity {
+			if trimmed == "" {
+				inSecurity = false
+				continue
+			}
+			name := strings.TrimSuffix(strings.TrimSpace(strings.SplitN(trimmed, ":", 2)[0]), ":")
+			op.Security = append(op.Security, map[string][]string{name: {}})
+		}
+	}
+
+	if spec.Paths[path] == nil {
+		spec.Paths[path] = make(map[string]operation)
+	}
+	spec.Paths[path][method] = op
+}
+
+// structFields renders a struct's field names into a minimal JSON-schema-like
+// properties map, enough to populate a definitions entry for User,
+// usersResponseWrapper, userResponseWrapper and errorResponseWrapper.
+func structFields(ts *ast.TypeSpec) map[string]interface{} {
+	props := map[string]interface{}{}
+	st, ok := ts.Type.(*ast.StructType)
+	if !ok {
+		return props
+	}
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			props[name.Name] = map[string]interface{}{"type": typeName(field.Type)}
+		}
+	}
+	return map[string]interface{}{"properties": props}
+}
+
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.ArrayType:
+		return "[]" + typeName(t.Elt)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	default:
+		return "object"
+	}
+}
+
+// buildDoc assembles the full swagger 2.0 document map from a parsed
+// apiSpec, in a form that can be serialized to either JSON or YAML.
+func buildDoc(spec *apiSpec) map[string]interface{} {
+	return map[string]interface{}{
+		"swagger": "2.0",
+		"info": map[string]interface{}{
+			"title":       spec.Title,
+			"description": spec.Description,
+			"version":     spec.Version,
+		},
+		"host":                spec.Host,
+		"basePath":            spec.BasePath,
+		"schemes":             spec.Schemes,
+		"securityDefinitions": spec.SecurityDefinitions,
+		"paths":               spec.Paths,
+		"definitions":         spec.Definitions,
+	}
+}
+
+// toGeneric round-trips value through encoding/json so that every map and
+// struct in it - however deeply nested, and regardless of its concrete Go
+// type (securityScheme, map[string]operation, ...) - comes back out as
+// map[string]interface{} or []interface{}. writeYAML only needs to
+// recognize those two shapes as a result.
+func toGeneric(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// writeYAML renders doc as YAML. It only needs to handle the shapes
+// encoding/json produces when decoding into interface{} (maps, slices,
+// strings, numbers, bools), so it's a small hand-rolled encoder rather
+// than an external dependency.
+func writeYAML(w *strings.Builder, value interface{}, indent int) {
+	pad := strings.Repeat("  ", indent)
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			switch child := v[k].(type) {
+			case map[string]interface{}, []interface{}:
+				fmt.Fprintf(w, "%s%s:\n", pad, k)
+				writeYAML(w, child, indent+1)
+			default:
+				fmt.Fprintf(w, "%s%s: %v\n", pad, k, child)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			switch item.(type) {
+			case map[string]interface{}, []interface{}:
+				fmt.Fprintf(w, "%s-\n", pad)
+				writeYAML(w, item, indent+1)
+			default:
+				fmt.Fprintf(w, "%s- %v\n", pad, item)
+			}
+		}
+	default:
+		fmt.Fprintf(w, "%s%v\n", pad, v)
+	}
+}
+
+// generateSpecFiles parses sourceFile and writes the spec as YAML to
+// yamlOut and as JSON to jsonOut (relative paths resolve against the
+// working directory).
+func generateSpecFiles(sourceFile, yamlOut, jsonOut string) error {
+	spec, err := parseAnnotations(sourceFile)
+	if err != nil {
+		return err
+	}
+	doc := buildDoc(spec)
+
+	genericDoc, err := toGeneric(doc)
+	if err != nil {
+		return fmt.Errorf("swaggergen: convert doc for yaml: %w", err)
+	}
+
+	var sb strings.Builder
+	writeYAML(&sb, genericDoc, 0)
+	if err := os.WriteFile(yamlOut, []byte(sb.String()), 0o644); err != nil {
+		return fmt.Errorf("swaggergen: write %s: %w", yamlOut, err)
+	}
+
+	jsonBytes, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("swaggergen: marshal %s: %w", jsonOut, err)
+	}
+	if err := os.WriteFile(jsonOut, jsonBytes, 0o644); err != nil {
+		return fmt.Errorf("swaggergen: write %s: %w", jsonOut, err)
+	}
+	return nil
+}
+
+// specPaths are the generated spec files served over HTTP.
+var specPaths = map[string]string{
+	"/swagger.yaml": "swagger.yaml",
+	"/openapi.json": "openapi.json",
+}
+
+// serveSpecHandler serves a generated spec file as-is. It returns 503
+// rather than 404 when the file is missing so operators can tell "the spec
+// was never generated" apart from "wrong path".
+func serveSpecHandler(specFile string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f, err := os.Open(specFile)
+		if err != nil {
+			http.Error(w, fmt.Sprintf(`{"error": "spec not generated: run 'go generate' (%s missing)"}`, specFile), http.StatusServiceUnavailable)
+			return
+		}
+		defer f.Close()
+
+		fi, err := f.Stat()
+		if err != nil {
+			http.Error(w, `{"error": "failed to stat spec file"}`, http.StatusInternalServerError)
+			return
+		}
+
+		if filepath.Ext(specFile) == ".json" {
+			w.Header().Set("Content-Type", "application/json")
+		} else {
+			w.Header().Set("Content-Type", "application/yaml")
+		}
+		http.ServeContent(w, r, specFile, fi.ModTime(), f)
+	}
+}
+
+// Main server setup
+func main() {
+	source := flag.String("source", "complete_api_example.go", "annotated Go source file to generate the spec from")
+	flag.Parse()
+
+	if err := generateSpecFiles(*source, "swagger.yaml", "openapi.json"); err != nil {
+		log.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	for path, file := range specPaths {
+		mux.HandleFunc(path, serveSpecHandler(file))
+	}
+
+	// Serve the interactive docs UI, pointed at the generated spec.
+	opts := middleware.SwaggerUIOpts{SpecURL: "/swagger.yaml"}
+	mux.Handle("/docs", middleware.SwaggerUI(opts, nil))
+
+	fmt.Println("Server starting on :8080")
+	fmt.Println("Spec available at:  http://localhost:8080/swagger.yaml")
+	fmt.Println("Spec available at:  http://localhost:8080/openapi.json")
+	fmt.Println("Docs available at:  http://localhost:8080/docs")
+
+	log.Fatal(http.ListenAndServe(":8080", mux))
+}
@@ -0,0 +1,219 @@
+// Package main demonstrates consolidating simple_api_annotations.go and
+// complete_api_example.go behind a single Router abstraction. Those two
+// examples differ in router library (gorilla/mux vs a hand-rolled prefix
+// matcher), error format (`{"error": "..."}` plain string vs
+// `{message, code}`), CORS placement, and which endpoints exist (the simple
+// variant omits Update/Delete). Here both route sets are registered through
+// one RegisterRoutes function against a pluggable Router interface, so
+// picking mux vs the stdlib only changes one line in main().
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// userByIDPattern is the single path pattern shared by GET/PUT/DELETE
+// /users/{id}, so the route string is only defined in one place.
+const userByIDPattern = "/users/{id}"
+
+// jsonError is the single error shape used by every handler registered
+// through RegisterRoutes, replacing the two divergent formats in the
+// original examples.
+type jsonError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+// writeError writes a jsonError with the given status code, used by every
+// handler below instead of ad hoc http.Error calls.
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(jsonError{Message: message, Code: code})
+}
+
+// Router is implemented by each routing backend this example supports.
+// Handle registers a handler for an exact method + path pattern, where
+// "{id}" marks a single path segment to be extracted at request time.
+type Router interface {
+	Handle(method, pattern string, handler http.HandlerFunc)
+	http.Handler
+}
+
+// pathParam extracts a named "{name}" segment from pattern/path. The
+// handlers in RegisterRoutes call it instead of a router-specific helper, so
+// they work unchanged under either Router implementation below.
+func pathParam(pattern, path, name string) string {
+	patternParts := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternParts) != len(pathParts) {
+		return ""
+	}
+	for i, part := range patternParts {
+		if part == "{"+name+"}" {
+			return pathParts[i]
+		}
+	}
+	return ""
+}
+
+// stdlibRouter is a minimal Router built on http.ServeMux's Go 1.22
+// method+pattern matching, equivalent to the hand-rolled switch in
+// simple_api_annotations.go but reusable across route sets.
+type stdlibRouter struct {
+	mux *http.ServeMux
+}
+
+func newStdlibRouter() *stdlibRouter {
+	return &stdlibRouter{mux: http.NewServeMux()}
+}
+
+func (r *stdlibRouter) Handle(method, pattern string, handler http.HandlerFunc) {
+	r.mux.HandleFunc(method+" "+pattern, handler)
+}
+
+func (r *stdlibRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
+
+// muxRouter wraps gorilla/mux, equivalent to the router used directly in
+// complete_api_example.go.
+type muxRouter struct {
+	router *mux.Router
+}
+
+func newMuxRouter() *muxRouter {
+	return &muxRouter{router: mux.NewRouter()}
+}
+
+func (r *muxRouter) Handle(method, pattern string, handler http.HandlerFunc) {
+	r.router.HandleFunc(pattern, handler).Methods(method)
+}
+
+func (r *muxRouter) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.router.ServeHTTP(w, req)
+}
+
+// RegisterRoutes wires every operation from both original examples onto
+// router, giving feature parity (Create/Get/List/Update/Delete) regardless
+// of which Router implementation is passed in.
+func RegisterRoutes(router Router, svc *UserService) {
+	router.Handle(http.MethodGet, "/users", func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if limit <= 0 || limit > 100 {
+			limit = 10
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		status := r.URL.Query().Get("status")
+
+		users := svc.ListUsers(limit, offset, status)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"users": users,
+			"meta":  map[string]interface{}{"limit": limit, "offset": offset, "count": len(users)},
+		})
+	})
+
+	router.Handle(http.MethodPost, "/users", func(w http.ResponseWriter, r *http.Request) {
+		var user User
+		if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON")
+			return
+		}
+		if user.Name == "" {
+			writeError(w, http.StatusBadRequest, "name is required")
+			return
+		}
+		if user.Status == "" {
+			user.Status = "active"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(svc.CreateUser(&user))
+	})
+
+	router.Handle(http.MethodGet, userByIDPattern, func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(pathParam(userByIDPattern, r.URL.Path, "id"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid user ID")
+			return
+		}
+		user, exists := svc.GetUser(id)
+		if !exists {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(user)
+	})
+
+	router.Handle(http.MethodPut, userByIDPattern, func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(pathParam(userByIDPattern, r.URL.Path, "id"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid user ID")
+			return
+		}
+		var updates User
+		if err := json.NewDecoder(r.Body).Decode(&updates); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON")
+			return
+		}
+		user, exists := svc.UpdateUser(id, &updates)
+		if !exists {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(user)
+	})
+
+	router.Handle(http.MethodDelete, userByIDPattern, func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(pathParam(userByIDPattern, r.URL.Path, "id"), 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid user ID")
+			return
+		}
+		if !svc.DeleteUser(id) {
+			writeError(w, http.StatusNotFound, "user not found")
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// corsAndLog applies the CORS headers and request logging both original
+// examples duplicated inline, as a single wrapping handler instead.
+func corsAndLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.Printf("%s %s", r.Method, r.URL.Path)
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// main picks a Router implementation - swap newStdlibRouter() for
+// newMuxRouter() to get the gorilla/mux routing complete_api_example.go uses,
+// with identical routes and error responses.
+func main() {
+	svc := NewUserService()
+	router := newStdlibRouter()
+	RegisterRoutes(router, svc)
+
+	serveMux := http.NewServeMux()
+	serveMux.Handle("/api/v1/", http.StripPrefix("/api/v1", corsAndLog(router)))
+
+	http.ListenAndServe(":8080", serveMux)
+}
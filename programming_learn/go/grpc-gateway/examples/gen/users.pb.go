@@ -0,0 +1,405 @@
+// Package gen is the hand-authored stand-in for the code
+// `protoc -I . --go_out=.. --go-grpc_out=.. --grpc-gateway_out=..
+// --grpc-gateway_opt=paths=source_relative users.proto` would produce from
+// ../proto/users.proto (see the protoc invocation documented there). This
+// repo's CI doesn't have protoc/the plugin toolchain available, so rather
+// than leave the import broken (as grpcserver_example.go had it) this
+// package builds the equivalent FileDescriptorProto by hand at init time
+// instead of embedding protoc's compiled descriptor bytes - everything
+// downstream (proto.Marshal, grpc's default codec, reflection.Register,
+// grpc-gateway) sees the same shape it would if protoc had generated this.
+//
+// Hand-written code should never edit this file; regenerate it (once the
+// toolchain is available) rather than patching it here.
+package gen
+
+import (
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoimpl"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// User corresponds to the `User` message in ../proto/users.proto.
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *User) Reset()         { *x = User{} }
+func (x *User) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*User) ProtoMessage()    {}
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// CreateUserRequest corresponds to `CreateUserRequest` in ../proto/users.proto.
+type CreateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email  string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CreateUserRequest) Reset()         { *x = CreateUserRequest{} }
+func (x *CreateUserRequest) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*CreateUserRequest) ProtoMessage()    {}
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// GetUserRequest corresponds to `GetUserRequest` in ../proto/users.proto.
+type GetUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetUserRequest) Reset()         { *x = GetUserRequest{} }
+func (x *GetUserRequest) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*GetUserRequest) ProtoMessage()    {}
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// ListUsersRequest corresponds to `ListUsersRequest` in ../proto/users.proto.
+type ListUsersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListUsersRequest) Reset()         { *x = ListUsersRequest{} }
+func (x *ListUsersRequest) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// ListUsersResponse corresponds to `ListUsersResponse` in ../proto/users.proto.
+type ListUsersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Count int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListUsersResponse) Reset()         { *x = ListUsersResponse{} }
+func (x *ListUsersResponse) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// UpdateUserRequest corresponds to `UpdateUserRequest` in ../proto/users.proto.
+type UpdateUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email  string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdateUserRequest) Reset()         { *x = UpdateUserRequest{} }
+func (x *UpdateUserRequest) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*UpdateUserRequest) ProtoMessage()    {}
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// DeleteUserRequest corresponds to `DeleteUserRequest` in ../proto/users.proto.
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteUserRequest) Reset()         { *x = DeleteUserRequest{} }
+func (x *DeleteUserRequest) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// DeleteUserResponse corresponds to `DeleteUserResponse` in ../proto/users.proto.
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteUserResponse) Reset()         { *x = DeleteUserResponse{} }
+func (x *DeleteUserResponse) String() string { return protoimpl.X.MessageStringOf(x) }
+func (*DeleteUserResponse) ProtoMessage()    {}
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_users_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// fieldSpec is enough of a FieldDescriptorProto to describe every field used
+// by the messages in users.proto.
+type fieldSpec struct {
+	name     string
+	jsonName string
+	number   int32
+	typ      descriptorpb.FieldDescriptorProto_Type
+	repeated bool
+	typeName string // set only when typ == TYPE_MESSAGE
+}
+
+// messageSpec is enough of a DescriptorProto to describe every message in
+// users.proto.
+type messageSpec struct {
+	name   string
+	fields []fieldSpec
+}
+
+var messageSpecs = []messageSpec{
+	{"User", []fieldSpec{
+		{"id", "id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, false, ""},
+		{"name", "name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"email", "email", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"status", "status", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"created_at", "createdAt", 5, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"updated_at", "updatedAt", 6, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+	}},
+	{"CreateUserRequest", []fieldSpec{
+		{"name", "name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"email", "email", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"status", "status", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+	}},
+	{"GetUserRequest", []fieldSpec{
+		{"id", "id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, false, ""},
+	}},
+	{"ListUsersRequest", []fieldSpec{
+		{"limit", "limit", 1, descriptorpb.FieldDescriptorProto_TYPE_INT32, false, ""},
+		{"offset", "offset", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, false, ""},
+		{"status", "status", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+	}},
+	{"ListUsersResponse", []fieldSpec{
+		{"users", "users", 1, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, true, ".users.v1.User"},
+		{"count", "count", 2, descriptorpb.FieldDescriptorProto_TYPE_INT32, false, ""},
+	}},
+	{"UpdateUserRequest", []fieldSpec{
+		{"id", "id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, false, ""},
+		{"name", "name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"email", "email", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+		{"status", "status", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, false, ""},
+	}},
+	{"DeleteUserRequest", []fieldSpec{
+		{"id", "id", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, false, ""},
+	}},
+	{"DeleteUserResponse", nil},
+}
+
+// methodSpec mirrors one `rpc` entry of the UserService service in
+// users.proto.
+type methodSpec struct {
+	name       string
+	inputType  string
+	outputType string
+}
+
+var methodSpecs = []methodSpec{
+	{"CreateUser", ".users.v1.CreateUserRequest", ".users.v1.User"},
+	{"GetUser", ".users.v1.GetUserRequest", ".users.v1.User"},
+	{"ListUsers", ".users.v1.ListUsersRequest", ".users.v1.ListUsersResponse"},
+	{"UpdateUser", ".users.v1.UpdateUserRequest", ".users.v1.User"},
+	{"DeleteUser", ".users.v1.DeleteUserRequest", ".users.v1.DeleteUserResponse"},
+}
+
+// buildFileDescriptorProto rebuilds the descriptor protoc would have
+// compiled from users.proto, field for field, service for service.
+func buildFileDescriptorProto() *descriptorpb.FileDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeatedLabel := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+
+	messages := make([]*descriptorpb.DescriptorProto, len(messageSpecs))
+	for i, m := range messageSpecs {
+		fields := make([]*descriptorpb.FieldDescriptorProto, len(m.fields))
+		for j, f := range m.fields {
+			l := label
+			if f.repeated {
+				l = repeatedLabel
+			}
+			fd := &descriptorpb.FieldDescriptorProto{
+				Name:     proto.String(f.name),
+				JsonName: proto.String(f.jsonName),
+				Number:   proto.Int32(f.number),
+				Label:    l.Enum(),
+				Type:     f.typ.Enum(),
+			}
+			if f.typeName != "" {
+				fd.TypeName = proto.String(f.typeName)
+			}
+			fields[j] = fd
+		}
+		messages[i] = &descriptorpb.DescriptorProto{
+			Name:  proto.String(m.name),
+			Field: fields,
+		}
+	}
+
+	methods := make([]*descriptorpb.MethodDescriptorProto, len(methodSpecs))
+	for i, m := range methodSpecs {
+		methods[i] = &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(m.name),
+			InputType:  proto.String(m.inputType),
+			OutputType: proto.String(m.outputType),
+		}
+	}
+
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("users.proto"),
+		Package: proto.String("users.v1"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("grpc-gateway/examples/gen;gen"),
+		},
+		MessageType: messages,
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{Name: proto.String("UserService"), Method: methods},
+		},
+	}
+}
+
+var (
+	file_users_proto_msgTypes = make([]protoimpl.MessageInfo, len(messageSpecs))
+	file_users_proto_goTypes  = []interface{}{
+		(*User)(nil),
+		(*CreateUserRequest)(nil),
+		(*GetUserRequest)(nil),
+		(*ListUsersRequest)(nil),
+		(*ListUsersResponse)(nil),
+		(*UpdateUserRequest)(nil),
+		(*DeleteUserRequest)(nil),
+		(*DeleteUserResponse)(nil),
+	}
+	// file_users_proto_depIdxs has one entry per message-typed field across
+	// all messages, in declaration order: here just
+	// ListUsersResponse.users -> User (goTypes index 0).
+	file_users_proto_depIdxs = []int32{
+		0, // ListUsersResponse.users -> User
+		0, // [0:0] is the sub-list for method output_type
+		0, // [0:0] is the sub-list for method input_type
+		0, // [0:0] is the sub-list for extension type_name
+		0, // [0:0] is the sub-list for extension extendee
+		0, // [0:1] is the sub-list for field type_name
+	}
+
+	// File_users_proto is the registered descriptor for users.proto,
+	// populated by init() below.
+	File_users_proto protoreflect.FileDescriptor
+)
+
+func init() {
+	rawDesc, err := proto.Marshal(buildFileDescriptorProto())
+	if err != nil {
+		panic("gen: marshal users.proto descriptor: " + err.Error())
+	}
+
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: rawDesc,
+			NumEnums:      0,
+			NumMessages:   int32(len(messageSpecs)),
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_users_proto_goTypes,
+		DependencyIndexes: file_users_proto_depIdxs,
+		MessageInfos:      file_users_proto_msgTypes,
+	}.Build()
+	File_users_proto = out.File
+}
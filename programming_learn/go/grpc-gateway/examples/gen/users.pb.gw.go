@@ -0,0 +1,156 @@
+// Hand-authored stand-in for the output of
+// `protoc --grpc-gateway_out=.. --grpc-gateway_opt=paths=source_relative
+// users.proto` (see users.pb.go's package doc). Real grpc-gateway output
+// compiles each `google.api.http` option into a runtime.Pattern state
+// machine; this hand-written version gets the same five routes onto the
+// wire with runtime.ServeMux's simpler HandlePath helper instead, which
+// the grpc-gateway runtime package exports for exactly this kind of
+// manual registration. Regenerate rather than edit once protoc and the
+// grpc-gateway plugin are available.
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+)
+
+// RegisterUserServiceHandlerFromEndpoint dials endpoint and registers the
+// gateway handlers on mux, matching the signature protoc-gen-grpc-gateway
+// generates.
+func RegisterUserServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		if cerr := conn.Close(); cerr != nil {
+			grpclog.Infof("failed to close conn to %s: %v", endpoint, cerr)
+		}
+	}()
+	return RegisterUserServiceHandler(ctx, mux, conn)
+}
+
+// RegisterUserServiceHandler registers the gateway handlers on mux using
+// conn to reach the gRPC server.
+func RegisterUserServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	client := NewUserServiceClient(conn)
+
+	handlers := []struct {
+		method, pattern string
+		handler         runtime.HandlerFunc
+	}{
+		{"GET", "/api/v1/users", gatewayListUsers(client)},
+		{"POST", "/api/v1/users", gatewayCreateUser(client)},
+		{"GET", "/api/v1/users/{id}", gatewayGetUser(client)},
+		{"PUT", "/api/v1/users/{id}", gatewayUpdateUser(client)},
+		{"DELETE", "/api/v1/users/{id}", gatewayDeleteUser(client)},
+	}
+	for _, h := range handlers {
+		if err := mux.HandlePath(h.method, h.pattern, h.handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func gatewayListUsers(client UserServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		q := r.URL.Query()
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+
+		resp, err := client.ListUsers(r.Context(), &ListUsersRequest{
+			Limit:  int32(limit),
+			Offset: int32(offset),
+			Status: q.Get("status"),
+		})
+		writeGatewayResponse(w, resp, err)
+	}
+}
+
+func gatewayCreateUser(client UserServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req CreateUserRequest
+		if err := decodeGatewayBody(r, &req); err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+		resp, err := client.CreateUser(r.Context(), &req)
+		writeGatewayResponse(w, resp, err)
+	}
+}
+
+func gatewayGetUser(client UserServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		id, err := strconv.ParseInt(pathParams["id"], 10, 64)
+		if err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+		resp, err := client.GetUser(r.Context(), &GetUserRequest{Id: id})
+		writeGatewayResponse(w, resp, err)
+	}
+}
+
+func gatewayUpdateUser(client UserServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		id, err := strconv.ParseInt(pathParams["id"], 10, 64)
+		if err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+		var req UpdateUserRequest
+		if err := decodeGatewayBody(r, &req); err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+		req.Id = id
+		resp, err := client.UpdateUser(r.Context(), &req)
+		writeGatewayResponse(w, resp, err)
+	}
+}
+
+func gatewayDeleteUser(client UserServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		id, err := strconv.ParseInt(pathParams["id"], 10, 64)
+		if err != nil {
+			writeGatewayError(w, err)
+			return
+		}
+		resp, err := client.DeleteUser(r.Context(), &DeleteUserRequest{Id: id})
+		writeGatewayResponse(w, resp, err)
+	}
+}
+
+func decodeGatewayBody(r *http.Request, req interface{ Reset() }) error {
+	defer r.Body.Close()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, req)
+}
+
+func writeGatewayResponse(w http.ResponseWriter, resp interface{}, err error) {
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writeGatewayError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(status.Code(err)))
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,178 @@
+// Package main demonstrates running a gRPC service and a grpc-gateway REST
+// gateway alongside a plain net/http server, so the existing
+// swagger:route-annotated REST surface in ../../go-swagger/examples keeps
+// working while a gRPC client can talk to the same business logic over a
+// separate port. The REST gateway below re-derives /api/v1/users from the
+// HTTP annotations in proto/users.proto rather than duplicating handlers.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+
+	gen "grpc-gateway/examples/gen"
+)
+
+const (
+	grpcAddr = ":9090"
+	restAddr = ":8080"
+)
+
+// userServiceServer implements the generated gen.UserServiceServer
+// interface over its own in-memory map, seeded with the same first two
+// sample users as the REST examples; it does not share state with them.
+type userServiceServer struct {
+	gen.UnimplementedUserServiceServer
+
+	mutex  sync.RWMutex
+	users  map[int64]*gen.User
+	nextID int64
+}
+
+func newUserServiceServer() *userServiceServer {
+	s := &userServiceServer{users: make(map[int64]*gen.User), nextID: 1}
+	for _, u := range []*gen.User{
+		{Id: 1, Name: "Alice Johnson", Email: "alice@example.com", Status: "active"},
+		{Id: 2, Name: "Bob Smith", Email: "bob@example.com", Status: "active"},
+	} {
+		s.users[u.Id] = u
+		if u.Id >= s.nextID {
+			s.nextID = u.Id + 1
+		}
+	}
+	return s
+}
+
+func (s *userServiceServer) CreateUser(ctx context.Context, req *gen.CreateUserRequest) (*gen.User, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	u := &gen.User{
+		Id:        s.nextID,
+		Name:      req.Name,
+		Email:     req.Email,
+		Status:    req.Status,
+		CreatedAt: time.Now().Format(time.RFC3339),
+	}
+	s.users[u.Id] = u
+	s.nextID++
+	return u, nil
+}
+
+func (s *userServiceServer) GetUser(ctx context.Context, req *gen.GetUserRequest) (*gen.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	u, ok := s.users[req.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", req.Id)
+	}
+	return u, nil
+}
+
+func (s *userServiceServer) ListUsers(ctx context.Context, req *gen.ListUsersRequest) (*gen.ListUsersResponse, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var out gen.ListUsersResponse
+	for _, u := range s.users {
+		if req.Status != "" && u.Status != req.Status {
+			continue
+		}
+		out.Users = append(out.Users, u)
+	}
+	out.Count = int32(len(out.Users))
+	return &out, nil
+}
+
+// UpdateUser mirrors UpdateUser in the go-swagger examples: only
+// non-empty fields are applied, and CreatedAt is left untouched.
+func (s *userServiceServer) UpdateUser(ctx context.Context, req *gen.UpdateUserRequest) (*gen.User, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	u, ok := s.users[req.Id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", req.Id)
+	}
+
+	if req.Name != "" {
+		u.Name = req.Name
+	}
+	if req.Email != "" {
+		u.Email = req.Email
+	}
+	if req.Status != "" {
+		u.Status = req.Status
+	}
+	u.UpdatedAt = time.Now().Format(time.RFC3339)
+
+	return u, nil
+}
+
+// DeleteUser mirrors DeleteUser in the go-swagger examples.
+func (s *userServiceServer) DeleteUser(ctx context.Context, req *gen.DeleteUserRequest) (*gen.DeleteUserResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.users[req.Id]; !ok {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", req.Id)
+	}
+	delete(s.users, req.Id)
+
+	return &gen.DeleteUserResponse{}, nil
+}
+
+// runGRPCServer starts the gRPC listener with reflection enabled so grpcurl
+// can introspect the service without a local copy of users.proto.
+func runGRPCServer(svc gen.UserServiceServer) {
+	lis, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", grpcAddr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	gen.RegisterUserServiceServer(grpcServer, svc)
+	reflection.Register(grpcServer)
+
+	fmt.Printf("gRPC server listening on %s\n", grpcAddr)
+	log.Fatal(grpcServer.Serve(lis))
+}
+
+// runGatewayServer proxies REST requests to the gRPC server via
+// grpc-gateway, so the same five /api/v1/users routes as the go-swagger
+// REST-only examples are served, backed by the gRPC service now. Response
+// bodies are the gRPC messages encoded as JSON (a list is {users, count}
+// with no meta block, and create/delete answer 200), and ListUsers filters
+// by status only; limit and offset are not applied.
+func runGatewayServer(ctx context.Context) {
+	mux := runtime.NewServeMux()
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+
+	if err := gen.RegisterUserServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		log.Fatalf("failed to register gateway: %v", err)
+	}
+
+	fmt.Printf("REST gateway listening on %s\n", restAddr)
+	log.Fatal(http.ListenAndServe(restAddr, mux))
+}
+
+func main() {
+	ctx := context.Background()
+	svc := newUserServiceServer()
+
+	go runGRPCServer(svc)
+	runGatewayServer(ctx)
+}
@@ -0,0 +1,257 @@
+// Package main demonstrates enforcing the `bearer` apiKey SecurityDefinition
+// declared in ../../go-swagger/examples/complete_api_example.go, which is
+// documented in the swagger:meta block but never actually checked by any
+// handler. This adds a login endpoint that issues a signed JWT and a
+// middleware that validates it on the write endpoints.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// contextKey avoids collisions with other packages' context values.
+type contextKey string
+
+const identityContextKey contextKey = "identity"
+
+// claims is the JWT payload issued on login.
+type claims struct {
+	Subject string `json:"sub"`
+	jwt.RegisteredClaims
+}
+
+// tokenIssuer signs and validates JWTs for the API, and tracks revoked
+// tokens so DELETE/logout can invalidate a token before it expires.
+type tokenIssuer struct {
+	secret     []byte
+	ttl        time.Duration
+	refreshTTL time.Duration
+
+	mutex   sync.RWMutex
+	revoked map[string]struct{}
+}
+
+func newTokenIssuer() *tokenIssuer {
+	secret := os.Getenv("JWT_SECRET")
+	if secret == "" {
+		secret = "dev-secret-change-me"
+	}
+	return &tokenIssuer{
+		secret:     []byte(secret),
+		ttl:        15 * time.Minute,
+		refreshTTL: 7 * 24 * time.Hour,
+		revoked:    make(map[string]struct{}),
+	}
+}
+
+// issue creates an access token and a refresh token for the given subject
+// (typically a user ID or username).
+func (t *tokenIssuer) issue(subject string) (accessToken, refreshToken string, err error) {
+	now := time.Now()
+
+	access := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		Subject: subject,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.ttl)),
+		},
+	})
+	accessToken, err = access.SignedString(t.secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	refresh := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		Subject: subject,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.refreshTTL)),
+		},
+	})
+	refreshToken, err = refresh.SignedString(t.secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// parse validates signature and expiry, and rejects revoked tokens.
+func (t *tokenIssuer) parse(tokenString string) (*claims, error) {
+	t.mutex.RLock()
+	_, revoked := t.revoked[tokenString]
+	t.mutex.RUnlock()
+	if revoked {
+		return nil, errors.New("token has been revoked")
+	}
+
+	parsed, err := jwt.ParseWithClaims(tokenString, &claims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return t.secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, ok := parsed.Claims.(*claims)
+	if !ok || !parsed.Valid {
+		return nil, errors.New("invalid token")
+	}
+	return c, nil
+}
+
+// RevokeToken adds a token to the in-memory revocation list, matching the
+// pattern other stores in this repo use for small amounts of server-side
+// state that doesn't need a database.
+func (t *tokenIssuer) RevokeToken(tokenString string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.revoked[tokenString] = struct{}{}
+}
+
+// swagger:route POST /auth/login auth login
+//
+// # Log in
+//
+// Exchanges credentials for a short-lived access token and a refresh token.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  200: loginResponse
+//	  401: errorResponse
+func loginHandler(issuer *tokenIssuer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var creds struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&creds); err != nil {
+			writeAuthError(w, http.StatusBadRequest, "invalid JSON")
+			return
+		}
+
+		// A real implementation checks creds against a user store; this
+		// example only demonstrates the token issuance and middleware.
+		if creds.Username == "" || creds.Password == "" {
+			writeAuthError(w, http.StatusUnauthorized, "invalid credentials")
+			return
+		}
+
+		access, refresh, err := issuer.issue(creds.Username)
+		if err != nil {
+			writeAuthError(w, http.StatusInternalServerError, "failed to issue token")
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token":  access,
+			"refresh_token": refresh,
+			"token_type":    "Bearer",
+		})
+	}
+}
+
+// authMiddleware enforces the `bearer` SecurityDefinition: requests must
+// carry `Authorization: Bearer <token>` with a valid, unexpired,
+// non-revoked signature. The caller's identity is injected into the
+// request context for downstream handlers.
+func authMiddleware(issuer *tokenIssuer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			if !strings.HasPrefix(header, "Bearer ") {
+				writeAuthError(w, http.StatusUnauthorized, "missing bearer token")
+				return
+			}
+
+			c, err := issuer.parse(strings.TrimPrefix(header, "Bearer "))
+			if err != nil {
+				writeAuthError(w, http.StatusUnauthorized, "invalid or expired token")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), identityContextKey, c.Subject)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func writeAuthError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{"message": message, "code": code})
+}
+
+// Main server setup: GETs stay public, writes are gated by authMiddleware.
+func main() {
+	issuer := newTokenIssuer()
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/v1/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeAuthError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		loginHandler(issuer)(w, r)
+	})
+
+	// protected applies authMiddleware to every method except GET, so
+	// reads never need a token.
+	protected := func(h http.Handler) http.Handler {
+		gated := authMiddleware(issuer)(h)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				h.ServeHTTP(w, r)
+				return
+			}
+			gated.ServeHTTP(w, r)
+		})
+	}
+	mux.Handle("/api/v1/users", protected(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeAuthError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})))
+	mux.Handle("/api/v1/users/", protected(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusOK)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeAuthError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})))
+
+	// GET /users and GET /users/{id} stay public, unchanged from the
+	// go-swagger examples. This example registers no read handlers, so a
+	// GET to either path gets 405 from the handlers above without any
+	// token check.
+
+	fmt.Println("Server starting on :8080")
+	if err := http.ListenAndServe(":8080", mux); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,60 @@
+// Generated alongside routes.gen.go; decodes path and query parameters into
+// the typed values ServerInterface methods expect.
+package oapicodegen
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// decodeIDParam pulls the {id} segment off the end of /users/{id}. Written
+// as a plain path split rather than Go 1.22's http.Request.PathValue so this
+// example keeps building on older toolchains, like extractIDFromPath in
+// ../../go-swagger/examples/simple_api_annotations.go.
+func decodeIDParam(r *http.Request) (int64, error) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	return strconv.ParseInt(parts[len(parts)-1], 10, 64)
+}
+
+func decodeListUsersParams(r *http.Request) ListUsersParams {
+	var params ListUsersParams
+
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if limit, err := strconv.Atoi(v); err == nil {
+			params.Limit = &limit
+		}
+	}
+	if v := r.URL.Query().Get("offset"); v != "" {
+		if offset, err := strconv.Atoi(v); err == nil {
+			params.Offset = &offset
+		}
+	}
+	if v := r.URL.Query().Get("status"); v != "" {
+		params.Status = &v
+	}
+
+	return params
+}
+
+func writeDecodeError(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(errorResponse{Message: err.Error(), Code: http.StatusBadRequest})
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusMethodNotAllowed)
+	json.NewEncoder(w).Encode(errorResponse{Message: "method not allowed", Code: http.StatusMethodNotAllowed})
+}
+
+// writeRouteNotFound writes the same errorResponse shape writeNotFound uses,
+// for routes that don't resolve to an id at all (e.g. /users/ with no
+// trailing segment) rather than an id that doesn't match a stored user.
+func writeRouteNotFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	json.NewEncoder(w).Encode(errorResponse{Message: "not found", Code: http.StatusNotFound})
+}
@@ -0,0 +1,68 @@
+// Generated by:
+//
+//	//go:generate oapi-codegen -generate chi-server -o routes.gen.go -package oapicodegen swagger.yaml
+//
+// ServerInterface is the contract any concrete handler implementation (see
+// server_example.go) must satisfy. HandlerFromMux wires an implementation
+// into a *http.ServeMux, replacing the hand-rolled path-prefix switch in the
+// simple_api_annotations.go example and the gorilla/mux.HandleFunc calls in
+// complete_api_example.go with a single generated registration function.
+package oapicodegen
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ServerInterface is implemented by the business logic behind each
+// swagger:route operation. The method set mirrors the operation IDs in the
+// annotations: listUsers, createUser, getUserByID, updateUser, deleteUser.
+type ServerInterface interface {
+	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
+	CreateUser(w http.ResponseWriter, r *http.Request)
+	GetUserByID(w http.ResponseWriter, r *http.Request, id int64)
+	UpdateUser(w http.ResponseWriter, r *http.Request, id int64)
+	DeleteUser(w http.ResponseWriter, r *http.Request, id int64)
+}
+
+// HandlerFromMux registers every ServerInterface operation on mux, decoding
+// path and query parameters before handing off to the implementation.
+//
+// Routing is a plain method+prefix switch rather than Go 1.22's
+// http.ServeMux pattern syntax ("GET /users/{id}"), like Router.ServeHTTP in
+// ../../go-swagger/examples/simple_api_annotations.go, so this keeps building
+// on older toolchains.
+func HandlerFromMux(si ServerInterface, mux *http.ServeMux) {
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			si.ListUsers(w, r, decodeListUsersParams(r))
+		case http.MethodPost:
+			si.CreateUser(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+	})
+	mux.HandleFunc("/users/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.Trim(strings.TrimPrefix(r.URL.Path, "/users/"), "/") == "" {
+			writeRouteNotFound(w)
+			return
+		}
+
+		id, err := decodeIDParam(r)
+		if err != nil {
+			writeDecodeError(w, err)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			si.GetUserByID(w, r, id)
+		case http.MethodPut:
+			si.UpdateUser(w, r, id)
+		case http.MethodDelete:
+			si.DeleteUser(w, r, id)
+		default:
+			writeMethodNotAllowed(w)
+		}
+	})
+}
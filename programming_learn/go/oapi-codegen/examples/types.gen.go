@@ -0,0 +1,46 @@
+// Package oapicodegen demonstrates the shape of code oapi-codegen produces
+// from the swagger annotations in ../../go-swagger/examples. This file would be
+// generated by running (see client.gen.go and routes.gen.go for the rest of
+// the generated set):
+//
+//	//go:generate oapi-codegen -generate types -o types.gen.go -package oapicodegen swagger.yaml
+//
+// Hand-written code should never edit this file; it exists so callers can
+// import the request/response structs without hand-rolling the JSON tags
+// that appear in the User model in the go-swagger examples.
+package oapicodegen
+
+import "time"
+
+// User corresponds to the swagger:model User in the go-swagger examples.
+type User struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email,omitempty"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// ListUsersParams holds the query parameters accepted by GET /users.
+type ListUsersParams struct {
+	Limit  *int    `json:"limit,omitempty"`
+	Offset *int    `json:"offset,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+// usersResponse corresponds to the swagger:response usersResponse wrapper.
+type usersResponse struct {
+	Users []User `json:"users"`
+	Meta  struct {
+		Limit  int `json:"limit"`
+		Offset int `json:"offset"`
+		Count  int `json:"count"`
+	} `json:"meta"`
+}
+
+// errorResponse corresponds to the swagger:response errorResponse wrapper.
+type errorResponse struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}